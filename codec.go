@@ -0,0 +1,124 @@
+package tuid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Codec encodes and decodes the 96-bit payload of a TUID (a nanosecond timestamp shifted left 32 bits,
+// OR'd with 32 bits of entropy) to and from a textual representation. Base62 is the default codec used
+// throughout the package; Crockford32 is provided as a human-friendlier alternative for IDs that appear in
+// URLs or are read aloud.
+type Codec interface {
+	// Encode the provided big integer into this codec's textual representation
+	Encode(value *big.Int) (string, error)
+	// Decode the provided text, in this codec's representation, into a big integer
+	Decode(text string) (*big.Int, error)
+	// Bounds returns this codec's encoding of MinID and MaxID, the earliest and latest valid TUIDs
+	Bounds() (minID string, maxID string)
+}
+
+// Crockford32MinID is MinID (the first ID at 2000-01-01T00:00:00Z), encoded with the Crockford32 codec
+const Crockford32MinID = "3939K7N4GR000000000"
+
+// Crockford32MaxID is MaxID (the first ID at 2100-01-01T00:00:00Z), encoded with the Crockford32 codec
+const Crockford32MaxID = "E7ESZ7ND9G000000000"
+
+// Base62 is the default Codec, encoding a TUID's payload as a base-62 big integer (digits, then uppercase,
+// then lowercase letters), as used by NewID and friends.
+var Base62 Codec = base62Codec{}
+
+// Crockford32 is a Codec that encodes a TUID's payload using the Crockford base-32 alphabet
+// "0123456789ABCDEFGHJKMNPQRSTVWXYZ" (which excludes I, L, O, and U to avoid confusion with 1 and 0). Decoding
+// is case-insensitive, treats I and L as 1 and O as 0, and ignores dashes inserted for readability.
+var Crockford32 Codec = crockford32Codec{}
+
+// NewIDWithCodec creates a new TUID with the current system time, encoded with the provided codec
+func NewIDWithCodec(codec Codec) TUID {
+	ts := new(big.Int).Lsh(big.NewInt(time.Now().UnixNano()), 32)
+	entropy, _ := randomEntropy()
+	id := ts.Or(ts, big.NewInt(int64(entropy)))
+	tuid, _ := codec.Encode(id)
+	return TUID(tuid)
+}
+
+// DecodeWith decodes the TUID's payload using the provided codec, rather than the default Base62 codec
+func (t TUID) DecodeWith(codec Codec) (*big.Int, error) {
+	return codec.Decode(string(t))
+}
+
+// base62Codec is the default Codec, backed by the package's original base-62 encode/decode functions
+type base62Codec struct{}
+
+func (base62Codec) Encode(value *big.Int) (string, error) {
+	return encode(value)
+}
+
+func (base62Codec) Decode(text string) (*big.Int, error) {
+	return decode(text)
+}
+
+func (base62Codec) Bounds() (string, string) {
+	return MinID, MaxID
+}
+
+// crockfordDigits is the Crockford base-32 alphabet, excluding I, L, O, and U
+var crockfordDigits = []byte("0123456789ABCDEFGHJKMNPQRSTVWXYZ")
+
+var crockfordBase = big.NewInt(32)
+
+// crockford32Codec implements Codec using the Crockford base-32 alphabet
+type crockford32Codec struct{}
+
+func (crockford32Codec) Encode(value *big.Int) (string, error) {
+	if value.Sign() < 0 {
+		return "", errors.New("crockford32 encoding error: positive value required")
+	}
+	var result []byte
+	for value.Sign() > 0 {
+		q, r := new(big.Int).DivMod(value, crockfordBase, new(big.Int))
+		d := crockfordDigits[r.Int64()]
+		result = append([]byte{d}, result...) // prepend the new digit
+		value = q
+	}
+	if len(result) == 0 {
+		return string(crockfordDigits[0]), nil
+	}
+	return string(result), nil
+}
+
+func (crockford32Codec) Decode(text string) (*big.Int, error) {
+	text = strings.ReplaceAll(text, "-", "")
+	textBytes := []byte(strings.ToUpper(text))
+	size := len(textBytes)
+	if size == 0 {
+		return new(big.Int), errors.New("crockford32 decoding error: no digits")
+	}
+	result := new(big.Int)
+	for i := 0; i < size; i++ {
+		b := textBytes[size-1-i] // examine digits from right to left
+		switch b {
+		case 'I', 'L':
+			b = '1'
+		case 'O':
+			b = '0'
+		}
+		j := int64(bytes.IndexByte(crockfordDigits, b))
+		if j == -1 {
+			msg := fmt.Sprintf("crockford32 decoding error: invalid digit `%s` in %s", string(b), string(textBytes))
+			return new(big.Int), errors.New(msg)
+		}
+		pow := new(big.Int).Exp(crockfordBase, big.NewInt(int64(i)), nil)
+		prod := new(big.Int).Mul(big.NewInt(j), pow)
+		result = new(big.Int).Add(result, prod)
+	}
+	return result, nil
+}
+
+func (crockford32Codec) Bounds() (string, string) {
+	return Crockford32MinID, Crockford32MaxID
+}
@@ -0,0 +1,116 @@
+package tuid
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCrockford32_EncodeDecode(t *testing.T) {
+	data := []encodingTest{
+		{"zero", 0, "0", ""},
+		{"positive", 1024, "100", ""},
+		{"negative", -1, "", "positive value required"},
+		{"boundary", 32, "10", ""},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			text, err := Crockford32.Encode(big.NewInt(d.i))
+			if text != d.s {
+				t.Errorf("expected %s, received %s", d.s, text)
+			}
+			var msg string
+			if err != nil {
+				msg = err.Error()
+			}
+			if !strings.Contains(msg, d.errorMessage) {
+				t.Errorf("expected error message `%s`, received `%s`", d.errorMessage, msg)
+			}
+		})
+	}
+}
+
+func TestCrockford32_DecodeSubstitutions(t *testing.T) {
+	data := []struct {
+		name     string
+		text     string
+		expected int64
+	}{
+		{"lowercase", "1a", 42},
+		{"dashes", "1-0", 32},
+		{"i-as-1", "1I", 33},
+		{"l-as-1", "1L", 33},
+		{"o-as-0", "1O", 32},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			value, err := Crockford32.Decode(d.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value.Int64() != d.expected {
+				t.Errorf("expected %d, received %d", d.expected, value)
+			}
+		})
+	}
+}
+
+func TestNewIDWithCodec(t *testing.T) {
+	tuid := NewIDWithCodec(Crockford32)
+	if tuid == "" {
+		t.Error("expected a TUID, got a zero value")
+	}
+	if !IsValidWithCodec(tuid, Crockford32) {
+		t.Errorf("expected %s to be valid", tuid)
+	}
+}
+
+func TestTuid_DecodeWith(t *testing.T) {
+	tuid := NewIDWithCodec(Crockford32)
+	_, err := tuid.DecodeWith(Crockford32)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsValidWithCodec_Crockford32(t *testing.T) {
+	data := []struct {
+		name  string
+		tuid  TUID
+		valid bool
+	}{
+		{"crockfordMinID", TUID(Crockford32MinID), true},
+		{"crockfordMaxID", TUID(Crockford32MaxID), true},
+		{"crockfordGenerated", NewIDWithCodec(Crockford32), true},
+	}
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			v := IsValidWithCodec(d.tuid, Crockford32)
+			if v != d.valid {
+				t.Errorf("expected %s validity to be %v", d.tuid, d.valid)
+			}
+		})
+	}
+}
+
+// TestIsValid_Base62NoLowercase is a regression test: a genuine Base62 TUID that happens to contain no
+// lowercase character must not be misdetected as Crockford32 and rejected. See
+// FirstIDWithTime(2032-10-19T04:48:00Z), which encodes to "B4KCXVKFT3AHXZE8".
+func TestIsValid_Base62NoLowercase(t *testing.T) {
+	when := time.Date(2032, 10, 19, 4, 48, 0, 0, time.UTC)
+	tuid := FirstIDWithTime(when)
+	if tuid != "B4KCXVKFT3AHXZE8" {
+		t.Fatalf("expected B4KCXVKFT3AHXZE8, received %s", tuid)
+	}
+	if !IsValid(tuid) {
+		t.Errorf("expected %s to be a valid Base62 TUID", tuid)
+	}
+	ts, err := tuid.Time()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ts.Equal(when) {
+		t.Errorf("expected %s, received %s", when, ts)
+	}
+}
@@ -0,0 +1,71 @@
+package tuid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// fastEncode and fastDecode implement the same base-62 encoding as encode/decode, but operate on a TUID's
+// 96-bit payload (a nanosecond timestamp shifted left 32 bits, OR'd with 32 bits of entropy) held as two
+// uint64 limbs instead of a math/big.Int. This keeps NewID, Time, Entropy, and Info allocation-free on the
+// hot path; TestUniqueIDs previously showed generation cost dominated by big.Int arithmetic.
+
+// packTimeEntropy packs a nanosecond timestamp and 32 bits of entropy into the two uint64 limbs (hi, lo) of
+// the 128-bit value ns<<32 | entropy
+func packTimeEntropy(ns int64, entropy uint32) (hi uint64, lo uint64) {
+	hi = uint64(ns) >> 32
+	lo = uint64(ns)<<32 | uint64(entropy)
+	return
+}
+
+// unpackTimeEntropy is the inverse of packTimeEntropy
+func unpackTimeEntropy(hi uint64, lo uint64) (ns int64, entropy uint32) {
+	ns = int64(hi<<32 | lo>>32)
+	entropy = uint32(lo)
+	return
+}
+
+// fastEncode encodes the 128-bit value (hi, lo) as a base-62 string, using plain uint64 arithmetic
+func fastEncode(hi uint64, lo uint64) string {
+	if hi == 0 && lo == 0 {
+		return string(digits[0])
+	}
+	var buf [32]byte
+	i := len(buf)
+	for hi != 0 || lo != 0 {
+		qHi, rHi := bits.Div64(0, hi, 62)
+		qLo, rLo := bits.Div64(rHi, lo, 62)
+		i--
+		buf[i] = digits[rLo]
+		hi, lo = qHi, qLo
+	}
+	return string(buf[i:])
+}
+
+// fastDecode decodes a base-62 string into a 128-bit value, returned as two uint64 limbs (hi, lo)
+func fastDecode(text string) (hi uint64, lo uint64, err error) {
+	if len(text) == 0 {
+		return 0, 0, errors.New("base 62 decoding error: no digits")
+	}
+	for i := 0; i < len(text); i++ {
+		j := bytes.IndexByte(digits, text[i])
+		if j == -1 {
+			return 0, 0, fmt.Errorf("base 62 decoding error: invalid digit `%c` in %s", text[i], text)
+		}
+		hi, lo = mul128by62(hi, lo)
+		var carry uint64
+		lo, carry = bits.Add64(lo, uint64(j), 0)
+		hi, _ = bits.Add64(hi, 0, carry)
+	}
+	return hi, lo, nil
+}
+
+// mul128by62 multiplies the 128-bit value (hi, lo) by 62, discarding any overflow beyond 128 bits (a TUID's
+// payload never exceeds 96 bits, so no overflow occurs for valid input)
+func mul128by62(hi uint64, lo uint64) (uint64, uint64) {
+	hiFromLo, newLo := bits.Mul64(lo, 62)
+	newHi := hi*62 + hiFromLo
+	return newHi, newLo
+}
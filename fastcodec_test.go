@@ -0,0 +1,63 @@
+package tuid
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestFastEncodeDecode(t *testing.T) {
+	data := []int64{0, 1, 1024, 62, 50014, 1_000_000, 1 << 40}
+	for _, v := range data {
+		hi, lo := packTimeEntropy(v, 0)
+		text := fastEncode(hi, lo)
+		expected, _ := encode(new(big.Int).Lsh(big.NewInt(v), 32))
+		if text != expected {
+			t.Errorf("expected %s, received %s", expected, text)
+		}
+		decodedHi, decodedLo, err := fastDecode(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ns, entropy := unpackTimeEntropy(decodedHi, decodedLo)
+		if ns != v || entropy != 0 {
+			t.Errorf("expected %d/0, received %d/%d", v, ns, entropy)
+		}
+	}
+}
+
+func TestFastDecode_InvalidDigit(t *testing.T) {
+	_, _, err := fastDecode("bad!")
+	if err == nil {
+		t.Error("expected an error for an invalid digit")
+	}
+}
+
+func TestTuid_BytesRoundTrip(t *testing.T) {
+	tuid := NewID()
+	bytes := tuid.Bytes()
+	roundTripped := FromBytes(bytes)
+	if roundTripped != tuid {
+		t.Errorf("expected round-tripped TUID %s to equal original %s", roundTripped, tuid)
+	}
+}
+
+func BenchmarkNewID(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NewID()
+	}
+}
+
+func BenchmarkTuid_Info(b *testing.B) {
+	tuid := NewID()
+	for i := 0; i < b.N; i++ {
+		_, _ = tuid.Info()
+	}
+}
+
+func BenchmarkNewIDWithTime(b *testing.B) {
+	now := time.Now()
+	for i := 0; i < b.N; i++ {
+		NewIDWithTime(now)
+	}
+}
@@ -0,0 +1,106 @@
+package tuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultBufferSize is the number of bytes a Generator reads from its entropy source at a time
+const DefaultBufferSize = 4096
+
+// Generator produces TUIDs using entropy drawn from a buffered pool, rather than reading crypto/rand.Reader
+// (one syscall) for every single ID. This amortizes the cost of reading randomness across many IDs, which
+// matters for workloads generating millions of IDs (see TestUniqueIDs). Generator is safe for concurrent use.
+type Generator struct {
+	mu     sync.Mutex
+	source io.Reader
+	buf    []byte
+	pos    int
+}
+
+// NewGenerator creates a Generator that reads entropy bufferSize bytes at a time from source. A bufferSize of
+// 0 selects DefaultBufferSize. Passing a deterministic source (e.g. a math/rand-backed io.Reader) is useful
+// in tests that need reproducible TUIDs.
+func NewGenerator(source io.Reader, bufferSize int) *Generator {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	buf := make([]byte, bufferSize)
+	return &Generator{source: source, buf: buf, pos: bufferSize}
+}
+
+// NextEntropy fills dst with entropy bytes drawn from the Generator's buffer, refilling the buffer from its
+// source as needed, like an io.Reader's Read method
+func (g *Generator) NextEntropy(dst []byte) (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	n := 0
+	for n < len(dst) {
+		if g.pos >= len(g.buf) {
+			if _, err := io.ReadFull(g.source, g.buf); err != nil {
+				return n, err
+			}
+			g.pos = 0
+		}
+		c := copy(dst[n:], g.buf[g.pos:])
+		g.pos += c
+		n += c
+	}
+	return n, nil
+}
+
+// entropy32 draws 32 bits of entropy from the Generator's buffer
+func (g *Generator) entropy32() (uint32, error) {
+	var b [4]byte
+	if _, err := g.NextEntropy(b[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+// NewID creates a new TUID with the current system time, drawing entropy from the Generator's buffer
+func (g *Generator) NewID() (TUID, error) {
+	return g.NewIDWithTime(time.Now())
+}
+
+// NewIDWithTime creates a TUID with the provided timestamp, drawing entropy from the Generator's buffer
+func (g *Generator) NewIDWithTime(t time.Time) (TUID, error) {
+	entropy, err := g.entropy32()
+	if err != nil {
+		return "", err
+	}
+	return NewIDWithTimeAndEntropy(t, entropy), nil
+}
+
+// NewBatch creates n new TUIDs with the current system time, drawing entropy for the whole batch from the
+// Generator's buffer with a single NextEntropy call rather than taking the lock once per ID. As with the
+// package-level NewID, an error reading entropy is treated as exceptional rather than part of the return
+// signature; it is ignored here, same as the package-level NewIDWithTime ignores it, and the IDs whose entropy
+// was not read get zero bytes in its place.
+func (g *Generator) NewBatch(n int) []TUID {
+	entropy := make([]byte, 4*n)
+	_, _ = g.NextEntropy(entropy)
+	ids := make([]TUID, n)
+	for i := range ids {
+		ids[i] = NewIDWithTimeAndEntropy(time.Now(), binary.BigEndian.Uint32(entropy[4*i:4*i+4]))
+	}
+	return ids
+}
+
+// generatorPool is a sync.Pool of Generators reading from crypto/rand.Reader, used by randomEntropy so that
+// tuid.NewID() and friends transparently benefit from buffered entropy without requiring an explicit Generator
+var generatorPool = sync.Pool{
+	New: func() interface{} {
+		return NewGenerator(rand.Reader, DefaultBufferSize)
+	},
+}
+
+// randomEntropy draws a fresh random 32-bit entropy value from the generator pool
+func randomEntropy() (uint32, error) {
+	g := generatorPool.Get().(*Generator)
+	defer generatorPool.Put(g)
+	return g.entropy32()
+}
@@ -0,0 +1,75 @@
+package tuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGenerator_NextEntropy(t *testing.T) {
+	source := bytes.NewReader([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+	g := NewGenerator(source, 4)
+	dst := make([]byte, 8)
+	n, err := g.NextEntropy(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 8 {
+		t.Errorf("expected 8 bytes, received %d", n)
+	}
+	expected := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	if !bytes.Equal(dst, expected) {
+		t.Errorf("expected %v, received %v", expected, dst)
+	}
+}
+
+func TestGenerator_NextEntropy_SourceExhausted(t *testing.T) {
+	source := bytes.NewReader([]byte{0x01, 0x02})
+	g := NewGenerator(source, 4)
+	dst := make([]byte, 4)
+	if _, err := g.NextEntropy(dst); err == nil {
+		t.Error("expected an error when the source is exhausted")
+	}
+}
+
+// sequentialBytes fills n bytes with an incrementing sequence, for deterministic test entropy
+func sequentialBytes(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+func TestGenerator_NewID(t *testing.T) {
+	g := NewGenerator(bytes.NewReader(sequentialBytes(DefaultBufferSize)), 0)
+	tuid, err := g.NewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tuid == "" {
+		t.Error("expected a TUID, got a zero value")
+	}
+}
+
+func TestGenerator_NewBatch(t *testing.T) {
+	g := NewGenerator(bytes.NewReader(sequentialBytes(DefaultBufferSize)), 0)
+	ids := g.NewBatch(10)
+	if len(ids) != 10 {
+		t.Errorf("expected 10 TUIDs, received %d", len(ids))
+	}
+	seen := map[TUID]struct{}{}
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	if len(seen) != 10 {
+		t.Errorf("expected 10 unique TUIDs, received %d", len(seen))
+	}
+}
+
+func TestDefaultGenerator_BenefitsNewID(t *testing.T) {
+	// NewID should transparently draw entropy from the pooled default Generator
+	tuid := NewID()
+	if tuid == "" {
+		t.Error("expected a TUID, got a zero value")
+	}
+}
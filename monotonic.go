@@ -0,0 +1,109 @@
+package tuid
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// DefaultMaxIncrement is the default upper bound (inclusive) on the random delta added to the entropy of the
+// previous TUID when MonotonicSource generates an ID with the same nanosecond timestamp as the last one.
+const DefaultMaxIncrement = 255
+
+// ErrMonotonicOverflow is returned by a strict MonotonicSource when the entropy of a TUID generated within the
+// same nanosecond as the previous one would overflow the 32-bit entropy field.
+var ErrMonotonicOverflow = errors.New("tuid: monotonic entropy overflow")
+
+// MonotonicSource generates TUIDs that are guaranteed to sort strictly after the previous TUID it generated,
+// even when multiple IDs are requested within the same nanosecond. When a new ID's timestamp matches the last
+// one generated, the entropy is incremented by a random delta (1 to MaxIncrement) rather than drawn fresh,
+// mirroring the approach used by oklog/ulid's Monotonic entropy source. MonotonicSource is safe for concurrent
+// use; a mutex guards the last-timestamp/last-entropy state.
+type MonotonicSource struct {
+	mu           sync.Mutex
+	lastTime     int64
+	lastEntropy  uint32
+	MaxIncrement uint32
+	Strict       bool
+}
+
+// NewMonotonicSource creates a MonotonicSource with the provided maximum entropy increment. A maxIncrement of
+// 0 selects DefaultMaxIncrement. By default, an entropy overflow bumps the timestamp by 1ns and draws fresh
+// entropy; set Strict on the returned source to instead return ErrMonotonicOverflow on overflow.
+func NewMonotonicSource(maxIncrement uint32) *MonotonicSource {
+	if maxIncrement == 0 {
+		maxIncrement = DefaultMaxIncrement
+	}
+	return &MonotonicSource{MaxIncrement: maxIncrement}
+}
+
+// NewID creates a new monotonic TUID with the current system time
+func (s *MonotonicSource) NewID() (TUID, error) {
+	return s.NewIDWithTime(time.Now())
+}
+
+// NewIDWithTime creates a monotonic TUID with the provided timestamp. If the timestamp is not strictly after
+// the one used for the previous TUID generated by this source — either because it's equal, or because it's
+// earlier (e.g. an NTP backward clock step) — the timestamp is clamped to the previous one and the entropy is
+// incremented by a random delta instead of being drawn fresh, guaranteeing the new TUID sorts after the
+// previous one.
+func (s *MonotonicSource) NewIDWithTime(t time.Time) (TUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ns, entropy, err := s.nextTimeAndEntropy(t.UnixNano())
+	if err != nil {
+		return "", err
+	}
+	s.lastTime = ns
+	s.lastEntropy = entropy
+	return NewIDWithTimeAndEntropy(time.Unix(0, ns), entropy), nil
+}
+
+// nextTimeAndEntropy computes the timestamp and entropy to use for a TUID requested at timestamp ns, assuming
+// the caller holds s.mu. The returned timestamp is ns, unless ns is not strictly after s.lastTime, in which
+// case it's clamped to s.lastTime (or bumped 1ns past it on entropy overflow).
+func (s *MonotonicSource) nextTimeAndEntropy(ns int64) (int64, uint32, error) {
+	if ns > s.lastTime {
+		entropy, err := randomEntropy()
+		return ns, entropy, err
+	}
+	ns = s.lastTime
+	maxIncrement := s.MaxIncrement
+	if maxIncrement == 0 {
+		maxIncrement = DefaultMaxIncrement
+	}
+	delta, err := randomDelta(maxIncrement)
+	if err != nil {
+		return ns, 0, err
+	}
+	next := s.lastEntropy + delta
+	if next < s.lastEntropy { // overflow of the 32-bit entropy field
+		if s.Strict {
+			return ns, 0, ErrMonotonicOverflow
+		}
+		entropy, err := randomEntropy()
+		return ns + 1, entropy, err
+	}
+	return ns, next, nil
+}
+
+// defaultMonotonicSource backs the package-level NewMonotonicID function
+var defaultMonotonicSource = NewMonotonicSource(DefaultMaxIncrement)
+
+// NewMonotonicID creates a new TUID using the package's default MonotonicSource, guaranteeing strict
+// lexicographic ordering relative to the previous TUID generated this way, even within the same nanosecond.
+func NewMonotonicID() TUID {
+	tuid, _ := defaultMonotonicSource.NewID()
+	return tuid
+}
+
+// randomDelta draws a random positive increment in the range [1, max]
+func randomDelta(max uint32) (uint32, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, err
+	}
+	return uint32(n.Int64()) + 1, nil
+}
@@ -0,0 +1,98 @@
+package tuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonotonicSource_SameTimestamp(t *testing.T) {
+	source := NewMonotonicSource(DefaultMaxIncrement)
+	now := time.Now()
+	first, err := source.NewIDWithTime(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := source.NewIDWithTime(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Compare(first, second) != -1 {
+		t.Errorf("expected %s to sort before %s", first, second)
+	}
+}
+
+func TestMonotonicSource_StrictOverflow(t *testing.T) {
+	now := time.Now()
+	source := NewMonotonicSource(1)
+	source.Strict = true
+	source.lastTime = now.UnixNano()
+	source.lastEntropy = 1<<32 - 1
+	_, err := source.NewIDWithTime(now)
+	if err != ErrMonotonicOverflow {
+		t.Errorf("expected ErrMonotonicOverflow, received %v", err)
+	}
+}
+
+func TestMonotonicSource_OverflowBumpsTimestamp(t *testing.T) {
+	now := time.Now()
+	source := NewMonotonicSource(1)
+	source.lastTime = now.UnixNano()
+	source.lastEntropy = 1<<32 - 1
+	tuid, err := source.NewIDWithTime(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := tuid.Time()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts.UnixNano() != now.UnixNano()+1 {
+		t.Error("expected overflow to bump the timestamp by 1ns")
+	}
+}
+
+func TestMonotonicSource_BackwardClockStep(t *testing.T) {
+	source := NewMonotonicSource(DefaultMaxIncrement)
+	now := time.Now()
+	first, err := source.NewIDWithTime(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := source.NewIDWithTime(now.Add(-time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Compare(first, second) != -1 {
+		t.Errorf("expected %s to sort before %s despite a backward clock step", first, second)
+	}
+}
+
+func TestMonotonicSource_ZeroValue(t *testing.T) {
+	// A zero-value MonotonicSource (e.g. &MonotonicSource{}) has MaxIncrement == 0, and must still fall back
+	// to DefaultMaxIncrement rather than passing 0 to randomDelta, which would panic.
+	var source MonotonicSource
+	now := time.Now()
+	first, err := source.NewIDWithTime(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := source.NewIDWithTime(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Compare(first, second) != -1 {
+		t.Errorf("expected %s to sort before %s", first, second)
+	}
+}
+
+func TestNewMonotonicID(t *testing.T) {
+	ids := make([]TUID, 1000)
+	for i := range ids {
+		ids[i] = NewMonotonicID()
+	}
+	for i := 1; i < len(ids); i++ {
+		if Compare(ids[i-1], ids[i]) != -1 {
+			t.Errorf("expected %s to sort before %s", ids[i-1], ids[i])
+		}
+	}
+}
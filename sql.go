@@ -0,0 +1,123 @@
+package tuid
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements the json.Marshaler interface
+func (t TUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(t))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, validating the TUID via IsValid
+func (t *TUID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	id := TUID(s)
+	if s != "" && !IsValid(id) {
+		return fmt.Errorf("tuid: invalid TUID %q", s)
+	}
+	*t = id
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (t TUID) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, validating the TUID via IsValid
+func (t *TUID) UnmarshalText(text []byte) error {
+	id := TUID(text)
+	if len(text) > 0 && !IsValid(id) {
+		return fmt.Errorf("tuid: invalid TUID %q", text)
+	}
+	*t = id
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, using the TUID's 12-byte binary form
+func (t TUID) MarshalBinary() ([]byte, error) {
+	if t == "" {
+		return []byte{}, nil
+	}
+	if !IsValid(t) {
+		return nil, fmt.Errorf("tuid: invalid TUID %q", string(t))
+	}
+	b := t.Bytes()
+	return b[:], nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface, the inverse of MarshalBinary
+func (t *TUID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ""
+		return nil
+	}
+	if len(data) != 12 {
+		return fmt.Errorf("tuid: invalid binary TUID length %d, expected 12", len(data))
+	}
+	var b [12]byte
+	copy(b[:], data)
+	*t = FromBytes(b)
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface
+func (t TUID) Value() (driver.Value, error) {
+	if t == "" {
+		return nil, nil
+	}
+	return string(t), nil
+}
+
+// Scan implements the database/sql.Scanner interface, validating the TUID via IsValid
+func (t *TUID) Scan(value interface{}) error {
+	if value == nil {
+		*t = ""
+		return nil
+	}
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("tuid: cannot scan %T into TUID", value)
+	}
+	id := TUID(s)
+	if s != "" && !IsValid(id) {
+		return fmt.Errorf("tuid: invalid TUID %q", s)
+	}
+	*t = id
+	return nil
+}
+
+// NullTUID represents a TUID that may be null, for use with database/sql, analogous to sql.NullString.
+type NullTUID struct {
+	TUID  TUID
+	Valid bool // Valid is true if TUID is not NULL
+}
+
+// Value implements the database/sql/driver.Valuer interface
+func (n NullTUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.TUID.Value()
+}
+
+// Scan implements the database/sql.Scanner interface
+func (n *NullTUID) Scan(value interface{}) error {
+	if value == nil {
+		n.TUID, n.Valid = "", false
+		return nil
+	}
+	n.Valid = true
+	return n.TUID.Scan(value)
+}
@@ -0,0 +1,140 @@
+package tuid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTuid_JSON(t *testing.T) {
+	tuid := NewID()
+	data, err := json.Marshal(tuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTripped TUID
+	if err := json.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripped != tuid {
+		t.Errorf("expected %s, received %s", tuid, roundTripped)
+	}
+}
+
+func TestTuid_UnmarshalJSON_Invalid(t *testing.T) {
+	var tuid TUID
+	err := json.Unmarshal([]byte(`"not a tuid"`), &tuid)
+	if err == nil {
+		t.Error("expected an error for an invalid TUID")
+	}
+}
+
+func TestTuid_TextMarshaling(t *testing.T) {
+	tuid := NewID()
+	text, err := tuid.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTripped TUID
+	if err := roundTripped.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripped != tuid {
+		t.Errorf("expected %s, received %s", tuid, roundTripped)
+	}
+}
+
+func TestTuid_BinaryMarshaling(t *testing.T) {
+	tuid := NewID()
+	data, err := tuid.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTripped TUID
+	if err := roundTripped.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripped != tuid {
+		t.Errorf("expected %s, received %s", tuid, roundTripped)
+	}
+}
+
+func TestTuid_Value(t *testing.T) {
+	tuid := NewID()
+	value, err := tuid.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != string(tuid) {
+		t.Errorf("expected %s, received %v", tuid, value)
+	}
+	var empty TUID
+	value, err = empty.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != nil {
+		t.Errorf("expected nil, received %v", value)
+	}
+}
+
+func TestTuid_Scan(t *testing.T) {
+	tuid := NewID()
+	var scanned TUID
+	if err := scanned.Scan(string(tuid)); err != nil {
+		t.Fatal(err)
+	}
+	if scanned != tuid {
+		t.Errorf("expected %s, received %s", tuid, scanned)
+	}
+	if err := scanned.Scan([]byte(tuid)); err != nil {
+		t.Fatal(err)
+	}
+	if scanned != tuid {
+		t.Errorf("expected %s, received %s", tuid, scanned)
+	}
+	if err := scanned.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if scanned != "" {
+		t.Errorf("expected zero value, received %s", scanned)
+	}
+	if err := scanned.Scan(42); err == nil {
+		t.Error("expected an error scanning an unsupported type")
+	}
+	if err := scanned.Scan("not a tuid"); err == nil {
+		t.Error("expected an error scanning an invalid TUID")
+	}
+}
+
+func TestNullTuid(t *testing.T) {
+	var n NullTUID
+	value, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != nil {
+		t.Errorf("expected nil, received %v", value)
+	}
+	tuid := NewID()
+	n = NullTUID{TUID: tuid, Valid: true}
+	value, err = n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != string(tuid) {
+		t.Errorf("expected %s, received %v", tuid, value)
+	}
+	var scanned NullTUID
+	if err := scanned.Scan(string(tuid)); err != nil {
+		t.Fatal(err)
+	}
+	if !scanned.Valid || scanned.TUID != tuid {
+		t.Errorf("expected valid %s, received valid=%v %s", tuid, scanned.Valid, scanned.TUID)
+	}
+	if err := scanned.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if scanned.Valid {
+		t.Error("expected Valid to be false after scanning nil")
+	}
+}
@@ -3,7 +3,7 @@ package tuid
 
 import (
 	"bytes"
-	"crypto/rand"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/big"
@@ -41,36 +41,32 @@ func (t TUID) Int() (*big.Int, error) {
 
 // Time extracts the embedded timestamp from the specified TUID
 func (t TUID) Time() (time.Time, error) {
-	id, err := decode(string(t))
+	hi, lo, err := fastDecode(string(t))
 	if err != nil {
 		return time.Time{}, err
 	}
-	nsec := new(big.Int).Rsh(id, 32)
-	return time.Unix(0, nsec.Int64()), nil
+	ns, _ := unpackTimeEntropy(hi, lo)
+	return time.Unix(0, ns), nil
 }
 
 // Entropy extracts the random 32 bits from the specified TUID
 func (t TUID) Entropy() (uint32, error) {
-	id, err := decode(string(t))
+	hi, lo, err := fastDecode(string(t))
 	if err != nil {
 		return 0, err
 	}
-	mask := big.NewInt(1<<32 - 1)
-	entropy := new(big.Int).And(id, mask)
-	return uint32(entropy.Int64()), nil
+	_, entropy := unpackTimeEntropy(hi, lo)
+	return entropy, nil
 }
 
 // Info extracts the timestamp and entropy from the specified TUID
 func (t TUID) Info() (TUIDInfo, error) {
-	id, err := decode(string(t))
+	hi, lo, err := fastDecode(string(t))
 	if err != nil {
 		return TUIDInfo{}, err
 	}
-	nsec := new(big.Int).Rsh(id, 32)
-	timestamp := time.Unix(0, nsec.Int64())
-	mask := big.NewInt(1<<32 - 1)
-	entropy := uint32(new(big.Int).And(id, mask).Int64())
-	return TUIDInfo{t, timestamp, entropy}, nil
+	ns, entropy := unpackTimeEntropy(hi, lo)
+	return TUIDInfo{t, time.Unix(0, ns), entropy}, nil
 }
 
 // String implements the fmt.Stringer interface
@@ -85,28 +81,64 @@ func NewID() TUID {
 
 // NewIDWithTime creates a TUID with the provided timestamp
 func NewIDWithTime(t time.Time) TUID {
-	ts := new(big.Int).Lsh(big.NewInt(t.UnixNano()), 32)
-	entropy, _ := rand.Int(rand.Reader, big.NewInt(1<<32))
-	id := ts.Or(ts, entropy)
-	tuid, _ := encode(id)
-	return TUID(tuid)
+	entropy, _ := randomEntropy()
+	hi, lo := packTimeEntropy(t.UnixNano(), entropy)
+	return TUID(fastEncode(hi, lo))
+}
+
+// NewIDWithTimeAndEntropy creates a TUID with the provided timestamp and entropy, useful for constructing a
+// TUID from previously extracted TUIDInfo, or for generators that manage entropy themselves (e.g. MonotonicSource)
+func NewIDWithTimeAndEntropy(t time.Time, entropy uint32) TUID {
+	hi, lo := packTimeEntropy(t.UnixNano(), entropy)
+	return TUID(fastEncode(hi, lo))
 }
 
 // FirstIDWithTime creates a TUID with the provided timestamp and zero entropy, useful for query offsets
 func FirstIDWithTime(t time.Time) TUID {
-	id := new(big.Int).Lsh(big.NewInt(t.UnixNano()), 32)
-	tuid, _ := encode(id)
-	return TUID(tuid)
+	hi, lo := packTimeEntropy(t.UnixNano(), 0)
+	return TUID(fastEncode(hi, lo))
+}
+
+// Bytes returns the TUID's 96-bit payload (nanosecond timestamp and 32 bits of entropy) as a 12-byte array,
+// for callers that want a compact binary form for storage. It returns the zero value if the TUID cannot be
+// decoded (it is empty or has a character outside the base-62 alphabet); it does not check the timestamp range.
+func (t TUID) Bytes() [12]byte {
+	var b [12]byte
+	hi, lo, err := fastDecode(string(t))
+	if err != nil {
+		return b
+	}
+	ns, entropy := unpackTimeEntropy(hi, lo)
+	binary.BigEndian.PutUint64(b[0:8], uint64(ns))
+	binary.BigEndian.PutUint32(b[8:12], entropy)
+	return b
+}
+
+// FromBytes creates a TUID from its 12-byte binary form, as produced by TUID.Bytes
+func FromBytes(b [12]byte) TUID {
+	ns := int64(binary.BigEndian.Uint64(b[0:8]))
+	entropy := binary.BigEndian.Uint32(b[8:12])
+	return NewIDWithTimeAndEntropy(time.Unix(0, ns), entropy)
 }
 
-// IsValid checks to see if the provided TUID has valid characters and a reasonable embedded timestamp
+// IsValid checks to see if the provided TUID has valid characters and a reasonable embedded timestamp,
+// assuming the default Base62 codec. Use IsValidWithCodec to validate a TUID encoded with another codec.
 func IsValid(t TUID) bool {
-	id, err := decode(string(t))
+	return IsValidWithCodec(t, Base62)
+}
+
+// IsValidWithCodec checks to see if the provided TUID has valid characters and a reasonable embedded
+// timestamp, decoding it with the provided codec. Crockford32's alphabet is a subset of Base62's, so there is
+// no reliable way to infer which codec produced a given TUID from its characters alone; callers must say
+// which codec they used.
+func IsValidWithCodec(t TUID, codec Codec) bool {
+	id, err := codec.Decode(string(t))
 	if err != nil {
 		return false
 	}
-	minID, _ := decode(MinID)
-	maxID, _ := decode(MaxID)
+	minText, maxText := codec.Bounds()
+	minID, _ := codec.Decode(minText)
+	maxID, _ := codec.Decode(maxText)
 	return (id.Cmp(minID) >= 0) && (id.Cmp(maxID) <= 0)
 }
 
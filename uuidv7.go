@@ -0,0 +1,76 @@
+package tuid
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// uuidV7Version is the 4-bit version field (0111) identifying an RFC 9562 UUIDv7
+const uuidV7Version = 0x7
+
+// uuidV7Variant is the 2-bit variant field (10) used by RFC 9562 UUIDs
+const uuidV7Variant = 0x2
+
+// NewUUIDv7 creates a new RFC 9562 UUIDv7 with the current timestamp (millisecond resolution) and fresh
+// 32 bits of entropy, for interop with systems that require standards-compliant UUIDs (e.g. a Postgres
+// uuid column, or the gofrs/uuid and google/uuid ecosystems) while still sorting chronologically.
+func NewUUIDv7() (uuid.UUID, error) {
+	entropy, err := randomEntropy()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	return timeAndEntropyToUUIDv7(time.Now(), entropy), nil
+}
+
+// ToUUIDv7 converts the TUID to an RFC 9562 UUIDv7, preserving the TUID's entropy exactly and its timestamp
+// to millisecond resolution. TUID stores nanosecond resolution; the sub-millisecond remainder is discarded,
+// so two TUIDs within the same millisecond are ordered by their entropy after conversion, which need not match
+// their original nanosecond order, and a TUID's original nanosecond timestamp cannot be recovered from the
+// resulting UUID.
+func (t TUID) ToUUIDv7() (uuid.UUID, error) {
+	info, err := t.Info()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	return timeAndEntropyToUUIDv7(info.Timestamp, info.Entropy), nil
+}
+
+// FromUUIDv7 converts an RFC 9562 UUIDv7 back into a TUID, recovering the millisecond timestamp and the
+// 32 bits of entropy that were packed into rand_a/rand_b by ToUUIDv7 or NewUUIDv7. The result sorts
+// chronologically alongside TUIDs generated directly, but only to millisecond resolution.
+func FromUUIDv7(u uuid.UUID) TUID {
+	ms := int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 | int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
+	randA := uint32(u[6]&0x0F)<<8 | uint32(u[7])
+	var fieldB uint64
+	for i := 8; i < 16; i++ {
+		fieldB = fieldB<<8 | uint64(u[i])
+	}
+	randB := fieldB & (1<<62 - 1)
+	entropy := randA<<20 | uint32(randB>>42)
+	return NewIDWithTimeAndEntropy(time.UnixMilli(ms), entropy)
+}
+
+// timeAndEntropyToUUIDv7 packs a timestamp (truncated to millisecond resolution) and 32 bits of entropy into
+// the RFC 9562 UUIDv7 byte layout: a 48-bit unix_ts_ms, a 4-bit version, a 12-bit rand_a, a 2-bit variant, and
+// a 62-bit rand_b. The entropy occupies the high-order 32 bits of the combined rand_a/rand_b field, so that
+// UUIDs sharing a millisecond are ordered by their entropy.
+func timeAndEntropyToUUIDv7(t time.Time, entropy uint32) uuid.UUID {
+	var u uuid.UUID
+	ms := uint64(t.UnixMilli())
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+	randA := (entropy >> 20) & 0x0FFF
+	u[6] = uuidV7Version<<4 | byte(randA>>8)
+	u[7] = byte(randA)
+	fieldB := uint64(uuidV7Variant)<<62 | uint64(entropy&0xFFFFF)<<42
+	for i := 15; i >= 8; i-- {
+		u[i] = byte(fieldB)
+		fieldB >>= 8
+	}
+	return u
+}
@@ -0,0 +1,71 @@
+package tuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTuid_ToUUIDv7(t *testing.T) {
+	now := time.Date(2021, 3, 8, 5, 54, 9, 208000000, time.UTC)
+	tuid := NewIDWithTime(now)
+	u, err := tuid.ToUUIDv7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (u[6] >> 4) != uuidV7Version {
+		t.Errorf("expected UUIDv7 version nibble, got %x", u[6]>>4)
+	}
+	if (u[8] >> 6) != uuidV7Variant {
+		t.Errorf("expected RFC 9562 variant bits, got %b", u[8]>>6)
+	}
+}
+
+func TestUUIDv7_RoundTrip(t *testing.T) {
+	// A timestamp with a non-zero sub-millisecond remainder: converting to UUIDv7 and back must lose that
+	// remainder (UUIDv7 only has millisecond resolution), so the round-tripped TUID differs from the original
+	// even though both agree once truncated to the millisecond.
+	now := time.UnixMilli(time.Now().UnixMilli()).Add(123456 * time.Nanosecond)
+	tuid := NewIDWithTime(now)
+	u, err := tuid.ToUUIDv7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTripped := FromUUIDv7(u)
+	if roundTripped == tuid {
+		t.Errorf("expected round-tripped TUID %s to differ from original %s due to ns->ms precision loss", roundTripped, tuid)
+	}
+	roundTrippedTime, err := roundTripped.Time()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !roundTrippedTime.Equal(time.UnixMilli(now.UnixMilli())) {
+		t.Errorf("expected round-tripped timestamp %s to equal the original truncated to milliseconds %s",
+			roundTrippedTime, time.UnixMilli(now.UnixMilli()))
+	}
+}
+
+func TestUUIDv7_SortOrderPreserved(t *testing.T) {
+	first := NewIDWithTime(time.Now())
+	second := NewIDWithTime(time.Now().Add(time.Millisecond))
+	firstUUID, err := first.ToUUIDv7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondUUID, err := second.ToUUIDv7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if firstUUID.String() >= secondUUID.String() {
+		t.Errorf("expected %s to sort before %s", firstUUID, secondUUID)
+	}
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	u, err := NewUUIDv7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (u[6] >> 4) != uuidV7Version {
+		t.Errorf("expected UUIDv7 version nibble, got %x", u[6]>>4)
+	}
+}